@@ -0,0 +1,41 @@
+package main
+
+import (
+	"os"
+
+	"github.com/Necroforger/Fantasia/system"
+)
+
+// runArchive exports or imports the database as requested by the -export and -import flags.
+// Bolt databases can only be opened by one process, so the bot must not be running.
+func runArchive(sys *system.System) {
+	if ImportPath != "" {
+		f, err := os.Open(ImportPath)
+		if err != nil {
+			sys.Log.Error("error opening archive", "path", ImportPath, "error", err)
+			return
+		}
+		defer f.Close()
+
+		n, err := sys.DB.Import(f, ArchiveGuild)
+		if err != nil {
+			sys.Log.Error("error importing archive", "path", ImportPath, "error", err)
+			return
+		}
+		sys.Log.Info("imported archive", "path", ImportPath, "records", n)
+		return
+	}
+
+	f, err := os.OpenFile(ExportPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		sys.Log.Error("error creating archive", "path", ExportPath, "error", err)
+		return
+	}
+	defer f.Close()
+
+	if err = sys.DB.Export(f, ArchiveGuild); err != nil {
+		sys.Log.Error("error exporting archive", "path", ExportPath, "error", err)
+		return
+	}
+	sys.Log.Info("exported database", "path", ExportPath)
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"os"
+
+	"github.com/Necroforger/Fantasia/system"
+)
+
+// consoleUser is the ID commands are run as in console mode when no admins are configured
+const consoleUser = "console"
+
+// runConsole runs the commands typed into the terminal until stdin is closed.
+// Commands are run as the first bot admin so that admin commands can be used.
+func runConsole(sys *system.System) {
+	userID := consoleUser
+	if len(sys.Config().Admins) > 0 {
+		userID = sys.Config().Admins[0]
+	}
+
+	output := system.NewConsoleOutput(os.Stdout, ConsoleFiles)
+	sys.Log.Info("reading commands from the console, commands do not need the prefix", "user", userID, "prefix", sys.Config().Prefix)
+
+	if err := sys.RunConsole(os.Stdin, output, userID); err != nil {
+		sys.Log.Error("error reading from the console", "error", err)
+	}
+}
@@ -29,6 +29,17 @@ var (
 	ConfigPath string
 	SelfBot    bool
 	Prefix     string
+
+	// ExportPath and ImportPath export or import a database archive instead of running the bot
+	ExportPath string
+	ImportPath string
+	// ArchiveGuild limits the export or import to a single guild
+	ArchiveGuild string
+
+	// Console runs commands typed into the terminal instead of connecting to discord
+	Console bool
+	// ConsoleFiles is the directory files uploaded in console mode are saved to
+	ConsoleFiles string
 )
 
 // Config ...
@@ -46,6 +57,11 @@ func parseFlags() {
 	flag.StringVar(&ConfigPath, "c", "config.toml", "configuration file path")
 	flag.BoolVar(&SelfBot, "s", false, "specifies if the bot is a selfbot")
 	flag.StringVar(&Prefix, "p", "", "Bot prefix")
+	flag.StringVar(&ExportPath, "export", "", "exports the database to a JSON archive and exits")
+	flag.StringVar(&ImportPath, "import", "", "imports a JSON archive into the database and exits")
+	flag.StringVar(&ArchiveGuild, "guild", "", "limits -export and -import to the records of a guild")
+	flag.BoolVar(&Console, "console", false, "runs commands read from stdin instead of connecting to discord")
+	flag.StringVar(&ConsoleFiles, "console-files", "", "directory files are saved to in -console mode. Defaults to a temporary directory")
 	flag.Parse()
 }
 
@@ -83,17 +99,7 @@ func main() {
 	}
 
 	// Override the configuration with the supplied command line arguments
-	if Token != "" {
-		conf.Token = Token
-	}
-
-	if SelfBot {
-		conf.System.Selfbot = true
-	}
-
-	if Prefix != "" {
-		conf.System.Prefix = Prefix
-	}
+	applyFlags(conf)
 
 	// Create the bot session
 	session, err := dream.New(conf.Dream, conf.Token)
@@ -103,54 +109,95 @@ func main() {
 	}
 	// session.DG.LogLevel = 10
 
+	archive := ExportPath != "" || ImportPath != ""
+
 	// Open the bot session
-	session.Open()
+	if !archive && !Console {
+		session.Open()
+	}
 
-	log.Println("Opening database file: ", conf.System.DatabaseFile)
+	log.Printf("Opening %s database: %s\n", conf.System.DatabaseBackend, conf.System.DatabaseFile)
 	sys, err := system.New(session, conf.System)
 	if err != nil {
 		log.Println("Error creating system: ", err)
 		return
 	}
-	RegisterModules(sys, conf.Modules)
 
-	// Remove disabled commands
-	for _, v := range conf.DisabledCommands {
-		log.Println("Disabling command: ", v)
-		sys.CommandRouter.SetDisabled(v, true)
+	if archive {
+		runArchive(sys)
+		sys.DB.Close()
+		return
 	}
 
-	// Whitelist specified commands
-	if len(conf.WhitelistCommands) != 0 {
-		routes := sys.CommandRouter.GetAllRoutes()
-		for _, route := range routes {
-			route.Disabled = true
-		}
-		for _, w := range conf.WhitelistCommands {
-			log.Println("Whitelisting command: ", w)
-			sys.CommandRouter.SetDisabled(w, false)
-		}
-	}
+	RegisterModules(sys, conf.Modules)
+
+	sys.SetCommandFilters(conf.DisabledCommands, conf.WhitelistCommands)
 
 	sys.ListenForCommands()
+	sys.StartBackups()
+	if err := sys.StartMetrics(); err != nil {
+		sys.Log.Error("error starting metrics server", "error", err)
+	}
+
+	// Reload the config file on SIGHUP, when it changes, or with the reload command
+	sys.SetConfigLoader(func() error { return reloadConfig(sys, conf) })
+	reloadOnSignal(sys)
+	if err := watchConfig(sys); err != nil {
+		sys.Log.Error("error watching config file", "path", ConfigPath, "error", err)
+	}
 
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Kill, os.Interrupt)
+
+	// Shut down once the console's input is closed
+	if Console {
+		go func() {
+			runConsole(sys)
+			c <- os.Interrupt
+		}()
+	}
+
 	<-c
-	log.Println("Shutting down...")
-	sys.DB.Close() // Close the database
+	sys.Log.Info("shutting down")
+
+	// A second interrupt exits without waiting
+	go func() {
+		<-c
+		sys.Log.Warn("forcing shutdown")
+		os.Exit(1)
+	}()
+
+	if err := sys.Shutdown(); err != nil {
+		sys.Log.Error("error shutting down", "error", err)
+	}
 }
 
 //////////////////////////////////////////////
 //               Config
 //////////////////////////////////////////////
 
+// applyFlags overrides the configuration with the supplied command line arguments
+func applyFlags(conf *Config) {
+	if Token != "" {
+		conf.Token = Token
+	}
+
+	if SelfBot {
+		conf.System.Selfbot = true
+	}
+
+	if Prefix != "" {
+		conf.System.Prefix = Prefix
+	}
+}
+
 // LoadConfig ...
 func LoadConfig(path string) (*Config, error) {
 	f, err := os.Open(path)
 	if err != nil {
 		return nil, ErrNotFound
 	}
+	defer f.Close()
 
 	var c Config
 
@@ -11,6 +11,7 @@ import (
 	"github.com/Necroforger/Fantasia/modules/images"
 	"github.com/Necroforger/Fantasia/modules/information"
 	"github.com/Necroforger/Fantasia/modules/musicplayer"
+	"github.com/Necroforger/Fantasia/modules/owner"
 	"github.com/Necroforger/Fantasia/modules/themeify"
 
 	"github.com/Necroforger/Fantasia/system"
@@ -32,6 +33,7 @@ type ModuleConfig struct {
 	Images      bool
 	Information bool
 	Musicplayer bool
+	Owner       bool
 	Themeify    bool
 
 	BooruConfig       *booru.Config
@@ -52,6 +54,7 @@ func NewModuleConfig() ModuleConfig {
 		Images:      true,
 		Information: true,
 		Musicplayer: true,
+		Owner:       true,
 		Themeify:    true,
 
 		BooruConfig:       booru.NewConfig(),
@@ -119,6 +122,11 @@ func RegisterModules(s *system.System, config ModuleConfig) {
 		}
 		log.Println("loaded musicplayer module...")
 	}
+	if (config.Inverted && !config.Owner) || (!config.Inverted && config.Owner) {
+		s.CommandRouter.SetCategory("Owner")
+		s.BuildModule(&owner.Module{})
+		log.Println("loaded owner module...")
+	}
 	if (config.Inverted && !config.Themeify) || (!config.Inverted && config.Themeify) {
 		s.CommandRouter.SetCategory("Themeify")
 		s.BuildModule(&themeify.Module{})
@@ -126,3 +134,21 @@ func RegisterModules(s *system.System, config ModuleConfig) {
 	}
 
 }
+
+// UpdateModuleConfig gives the running modules the configurations of a reloaded config file.
+// The configurations are replaced rather than copied into, as commands may be reading them.
+func UpdateModuleConfig(s *system.System, reloaded ModuleConfig) {
+	if m, ok := s.Module("booru").(*booru.Module); ok && reloaded.BooruConfig != nil {
+		m.SetConfig(reloaded.BooruConfig)
+	}
+	if m, ok := s.Module("dashboard").(*dashboard.Module); ok && reloaded.DashboardConfig != nil {
+		m.SetConfig(reloaded.DashboardConfig)
+	}
+	if m, ok := s.Module("images").(*images.Module); ok && reloaded.ImagesConfig != nil {
+		m.SetConfig(reloaded.ImagesConfig)
+	}
+	if m, ok := s.Module("musicplayer").(*musicplayer.Module); ok && reloaded.MusicplayerConfig != nil {
+		m.SetConfig(reloaded.MusicplayerConfig)
+	}
+
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/Necroforger/Fantasia/system"
+
+	"github.com/howeyc/fsnotify"
+)
+
+// configReloadDelay is how long to wait after the config file changes before reloading it,
+// So that an editor saving the file in several writes only causes one reload.
+const configReloadDelay = time.Millisecond * 500
+
+// reloadMu prevents the config from being reloaded by several sources at once
+var reloadMu sync.Mutex
+
+// reloadConfig reloads the config file and applies it to the running bot.
+// The token, dream config and which modules are loaded only change when the bot is restarted.
+//		sys:  The running system
+//		conf: The config the bot was started with. It is updated with the reloaded values.
+func reloadConfig(sys *system.System, conf *Config) error {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	reloaded, err := LoadConfig(ConfigPath)
+	if err != nil {
+		return err
+	}
+	applyFlags(reloaded)
+
+	sys.SetConfig(reloaded.System)
+	UpdateModuleConfig(sys, reloaded.Modules)
+	conf.System = reloaded.System
+	conf.DisabledCommands = reloaded.DisabledCommands
+	conf.WhitelistCommands = reloaded.WhitelistCommands
+
+	// The filters are applied again to the routes modules replace when reloading
+	sys.SetCommandFilters(conf.DisabledCommands, conf.WhitelistCommands)
+	err = sys.NotifyConfigReloaded()
+
+	sys.Log.Info("reloaded config file", "path", ConfigPath)
+	return err
+}
+
+// reloadOnSignal reloads the config file when the process receives SIGHUP
+func reloadOnSignal(sys *system.System) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGHUP)
+
+	go func() {
+		for range c {
+			if err := sys.ReloadConfig(); err != nil {
+				sys.Log.Error("error reloading config", "error", err)
+			}
+		}
+	}()
+}
+
+// watchConfig reloads the config file when it changes.
+// The file's directory is watched as editors often replace the file instead of writing to it.
+func watchConfig(sys *system.System) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+
+	path, err := filepath.Abs(ConfigPath)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		var timer *time.Timer
+		for {
+			select {
+			case ev := <-watcher.Event:
+				if name, err := filepath.Abs(ev.Name); err != nil || name != path {
+					continue
+				}
+				if timer != nil {
+					timer.Stop()
+				}
+				timer = time.AfterFunc(configReloadDelay, func() {
+					if err := sys.ReloadConfig(); err != nil {
+						sys.Log.Error("error reloading config", "error", err)
+					}
+				})
+			case err := <-watcher.Error:
+				sys.Log.Error("config watcher error", "error", err)
+			}
+		}
+	}()
+
+	return watcher.Watch(filepath.Dir(path))
+}
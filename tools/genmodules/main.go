@@ -75,6 +75,16 @@ func RegisterModules(s *system.System, config ModuleConfig) {
 	{{end}}
 }
 
+// UpdateModuleConfig gives the running modules the configurations of a reloaded config file.
+// The configurations are replaced rather than copied into, as commands may be reading them.
+func UpdateModuleConfig(s *system.System, reloaded ModuleConfig) {
+	{{range . -}}{{if .HasConfig -}}
+	if m, ok := s.Module("{{.Name}}").(*{{.Name}}.Module); ok && reloaded.{{title .Name}}Config != nil {
+		m.SetConfig(reloaded.{{title .Name}}Config)
+	}
+	{{end}}{{end}}
+}
+
 `))
 
 // ModuleList ...